@@ -0,0 +1,152 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package routing
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/acsellers/inflections"
+)
+
+var (
+	contextType = reflect.TypeOf((*Context)(nil))
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// RouteSpec overrides the HTTP methods, path, and middleware that
+// RegisterController would otherwise infer from a controller method name.
+type RouteSpec struct {
+	Methods    []string
+	Path       string
+	Middleware []Handler
+}
+
+// verbPrefixes maps a leading Go method name segment to the HTTP methods
+// RegisterController infers for it, checked in order so "Any" never shadows
+// a more specific prefix tried earlier.
+var verbPrefixes = []struct {
+	prefix  string
+	methods []string
+}{
+	{"Get", []string{"GET"}},
+	{"Post", []string{"POST"}},
+	{"Put", []string{"PUT"}},
+	{"Patch", []string{"PATCH"}},
+	{"Delete", []string{"DELETE"}},
+	{"Head", []string{"HEAD"}},
+	{"Options", []string{"OPTIONS"}},
+	{"Any", Methods},
+}
+
+// RegisterController registers every exported func(*routing.Context) error
+// method of controller - a struct value or pointer, possibly embedding other
+// controllers - as a route under prefix. Handler methods are found by
+// walking controller's reflect.Type method set (t.NumMethod()/t.Method(i)),
+// which already includes methods promoted from embedded controllers;
+// reflect.Value.MethodByName is used separately, only to look up a method's
+// optional XxxRoute companion.
+//
+// The HTTP method and path are inferred from the Go method name: GetUsers
+// becomes GET /users, PostLogin becomes POST /login, AnyHealth responds to
+// every method in Methods, and a method without a recognized verb prefix
+// falls back to Any. A method Xxx may define a companion XxxRoute()
+// RouteSpec method that overrides the inferred methods, path and
+// middleware entirely.
+func (r *RouteGroup) RegisterController(prefix string, controller interface{}) {
+	for _, cr := range controllerRoutes(prefix, controller) {
+		r.Match(cr.Methods, cr.Path, append(cr.Middleware, cr.Handler)...)
+	}
+}
+
+// controllerRoute pairs the HTTP methods and path inferred (or overridden)
+// for a controller method with the Handler that invokes it.
+type controllerRoute struct {
+	Methods    []string
+	Path       string
+	Middleware []Handler
+	Handler    Handler
+}
+
+// controllerRoutes computes the routes RegisterController would register
+// for controller, without touching any RouteGroup. Kept separate from
+// RegisterController so verb inference, RouteSpec overrides, and
+// receiver/embedding discovery can be tested without a live router.
+func controllerRoutes(prefix string, controller interface{}) []controllerRoute {
+	v := reflect.ValueOf(controller)
+	t := v.Type()
+	var routes []controllerRoute
+	for i := 0; i < t.NumMethod(); i++ {
+		method := t.Method(i)
+		if !isHandlerMethod(method) {
+			continue
+		}
+
+		spec := inflectRoute(method.Name)
+		if custom, ok := routeSpecOverride(v, method.Name); ok {
+			spec = custom
+		}
+
+		routes = append(routes, controllerRoute{
+			Methods:    spec.Methods,
+			Path:       prefix + spec.Path,
+			Middleware: spec.Middleware,
+			Handler:    bindControllerMethod(v, method),
+		})
+	}
+	return routes
+}
+
+// isHandlerMethod reports whether method has the signature
+// func(*routing.Context) error, i.e. is a candidate to become a route.
+func isHandlerMethod(method reflect.Method) bool {
+	return method.Type.NumIn() == 2 && method.Type.In(1) == contextType &&
+		method.Type.NumOut() == 1 && method.Type.Out(0) == errorType
+}
+
+// routeSpecOverride calls the controller's XxxRoute() RouteSpec companion
+// method for handler method name, if one exists.
+func routeSpecOverride(v reflect.Value, name string) (RouteSpec, bool) {
+	m := v.MethodByName(name + "Route")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0) != routeSpecType {
+		return RouteSpec{}, false
+	}
+	return m.Call(nil)[0].Interface().(RouteSpec), true
+}
+
+var routeSpecType = reflect.TypeOf(RouteSpec{})
+
+// inflectRoute derives the default RouteSpec for a controller method name by
+// matching a known HTTP-verb prefix, including Any (AnyHealth -> Methods
+// /health), and stripping that prefix the same way for all of them. A
+// method whose name doesn't start with any recognized prefix falls back to
+// Any, keeping the full name as its path.
+func inflectRoute(name string) RouteSpec {
+	for _, vp := range verbPrefixes {
+		if !strings.HasPrefix(name, vp.prefix) || name == vp.prefix {
+			continue
+		}
+		return RouteSpec{
+			Methods: vp.methods,
+			Path:    "/" + inflections.Underscore(name[len(vp.prefix):]),
+		}
+	}
+	return RouteSpec{
+		Methods: Methods,
+		Path:    "/" + inflections.Underscore(name),
+	}
+}
+
+// bindControllerMethod adapts a reflected controller method to a Handler,
+// calling it with receiver v and the incoming Context.
+func bindControllerMethod(v reflect.Value, method reflect.Method) Handler {
+	return func(c *Context) error {
+		out := method.Func.Call([]reflect.Value{v, reflect.ValueOf(c)})
+		if err, ok := out[0].Interface().(error); ok {
+			return err
+		}
+		return nil
+	}
+}
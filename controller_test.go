@@ -0,0 +1,118 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package routing
+
+import (
+	"reflect"
+	"testing"
+)
+
+type embeddedController struct {
+	pinged bool
+}
+
+func (c *embeddedController) GetPing(ctx *Context) error {
+	c.pinged = true
+	return nil
+}
+
+type testController struct {
+	embeddedController
+	called []string
+}
+
+func (c *testController) GetUsers(ctx *Context) error {
+	c.called = append(c.called, "GetUsers")
+	return nil
+}
+
+func (c *testController) PostLogin(ctx *Context) error {
+	c.called = append(c.called, "PostLogin")
+	return nil
+}
+
+func (c *testController) AnyHealth(ctx *Context) error {
+	c.called = append(c.called, "AnyHealth")
+	return nil
+}
+
+func (c *testController) Custom(ctx *Context) error {
+	c.called = append(c.called, "Custom")
+	return nil
+}
+
+func (c *testController) CustomRoute() RouteSpec {
+	return RouteSpec{Methods: []string{"GET"}, Path: "/special"}
+}
+
+func TestInflectRoute(t *testing.T) {
+	cases := []struct {
+		name    string
+		methods []string
+		path    string
+	}{
+		{"GetUsers", []string{"GET"}, "/users"},
+		{"PostLogin", []string{"POST"}, "/login"},
+		{"AnyHealth", Methods, "/health"},
+		{"Ping", Methods, "/ping"},
+	}
+	for _, c := range cases {
+		spec := inflectRoute(c.name)
+		if spec.Path != c.path {
+			t.Errorf("%s: path = %q, want %q", c.name, spec.Path, c.path)
+		}
+		if !reflect.DeepEqual(spec.Methods, c.methods) {
+			t.Errorf("%s: methods = %v, want %v", c.name, spec.Methods, c.methods)
+		}
+	}
+}
+
+func routeByPath(routes []controllerRoute, path string) *controllerRoute {
+	for i := range routes {
+		if routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestControllerRoutesRouteSpecOverride(t *testing.T) {
+	routes := controllerRoutes("", &testController{})
+
+	custom := routeByPath(routes, "/special")
+	if custom == nil {
+		t.Fatal("CustomRoute() override was not applied; /special route missing")
+	}
+	if !reflect.DeepEqual(custom.Methods, []string{"GET"}) {
+		t.Errorf("override methods = %v, want [GET]", custom.Methods)
+	}
+	if routeByPath(routes, "/custom") != nil {
+		t.Error("inflected /custom route should have been replaced by the override")
+	}
+}
+
+func TestControllerRoutesPointerAndEmbeddedReceivers(t *testing.T) {
+	ctrl := &testController{}
+	routes := controllerRoutes("", ctrl)
+
+	ping := routeByPath(routes, "/ping")
+	if ping == nil {
+		t.Fatal("embedded controller method GetPing was not discovered")
+	}
+	if err := ping.Handler(&Context{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ctrl.pinged {
+		t.Error("embedded pointer-receiver method was not actually invoked")
+	}
+}
+
+func TestControllerRoutesBasePrefix(t *testing.T) {
+	routes := controllerRoutes("/v1", &testController{})
+
+	if routeByPath(routes, "/v1/users") == nil {
+		t.Errorf("expected a route under base prefix /v1, got %+v", routes)
+	}
+}
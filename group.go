@@ -5,11 +5,14 @@
 package routing
 
 import (
-	"github.com/acsellers/inflections"
-	"reflect"
+	"regexp"
 	"strings"
 )
 
+// methodNameRegex validates custom HTTP verbs passed to Handle and Match,
+// such as WebDAV's PROPFIND or MKCOL.
+var methodNameRegex = regexp.MustCompile(`^[A-Z]+$`)
+
 // RouteGroup represents a group of routes that share the same path prefix.
 type RouteGroup struct {
 	prefix   string
@@ -80,37 +83,31 @@ func (r *RouteGroup) Any(path string, handlers ...Handler) *Route {
 	return route
 }
 
-// RegisterController Registers a controller (i.e. struct) with functions
-// of the type: 'func(*routing.Context) error' and skips all other functions
-func (r *RouteGroup) RegisterController(controller interface{}) {
-	t := reflect.TypeOf(controller)
-	for i := 0; i < t.NumMethod(); i++ {
-		method := t.Method(i)
-		// Check for func(*routing.Context) error
-		if method.Type.NumOut() == 1 && method.Type.Out(0).Name() == "error" &&
-			method.Type.NumIn() == 2 && method.Type.In(1).String() == "*routing.Context" {
-			uri := "/" + inflections.Underscore(t.Name())
-			uri += "/" + inflections.Underscore(method.Name)
-			mt, found := t.MethodByName(method.Name + "Params")
-			if found {
-				r := mt.Func.Call([]reflect.Value{reflect.ValueOf(controller)})
-				if len(r) == 1 {
-					uri += "/" + r[1].Interface().(string)
-				}
-			}
-			r.Any(uri, func(ctx *Context) error {
-				var params []reflect.Value
-				params = append(params, reflect.ValueOf(controller))
-				params = append(params, reflect.ValueOf(ctx))
-				errVal := method.Func.Call(params)
-				i := errVal[0].Interface()
-				if i != nil {
-					return i.(error)
-				}
-				return nil
-			})
+// Handle adds a route with the given HTTP method, route path, and handlers.
+// Unlike the named methods (Get, Post, ...), it accepts any method matching
+// ^[A-Z]+$, so custom verbs such as WebDAV's PROPFIND or MKCOL are supported.
+func (r *RouteGroup) Handle(method string, path string, handlers ...Handler) *Route {
+	if !methodNameRegex.MatchString(method) {
+		panic("routing: invalid HTTP method " + method)
+	}
+	route := newRoute(path, r)
+	route.add(method, handlers)
+	return route
+}
+
+// Match adds a route that responds to all of the given HTTP methods. It is
+// the slice-based counterpart to To, which takes a comma-joined string.
+func (r *RouteGroup) Match(methods []string, path string, handlers ...Handler) *Route {
+	for _, method := range methods {
+		if !methodNameRegex.MatchString(method) {
+			panic("routing: invalid HTTP method " + method)
 		}
 	}
+	route := newRoute(path, r)
+	for _, method := range methods {
+		route.add(method, handlers)
+	}
+	return route
 }
 
 // To adds a route to the router with the given HTTP methods, route path, and handlers.
@@ -135,8 +132,31 @@ func (r *RouteGroup) Group(prefix string, handlers ...Handler) *RouteGroup {
 	return newRouteGroup(r.prefix+prefix, r.router, handlers)
 }
 
+// BasePath returns the path prefix accumulated by this group and its
+// ancestors.
+func (r *RouteGroup) BasePath() string {
+	return r.prefix
+}
+
 // Use registers one or multiple handlers to the current route group.
 // These handlers will be shared by all routes belong to this group and its subgroups.
 func (r *RouteGroup) Use(handlers ...Handler) {
 	r.handlers = append(r.handlers, handlers...)
 }
+
+// Middleware is an alias for Use that returns the group itself, enabling a
+// fluent chain such as r.Group("/api").Middleware(auth).Children(...).
+func (r *RouteGroup) Middleware(handlers ...Handler) *RouteGroup {
+	r.Use(handlers...)
+	return r
+}
+
+// Children attaches each GroupBuilder as a child of r, inheriting r's
+// prefix and handler chain. A builder's own Middleware() calls apply only
+// within its own subtree, not to r or its siblings.
+func (r *RouteGroup) Children(children ...*GroupBuilder) *RouteGroup {
+	for _, child := range children {
+		child.build(r)
+	}
+	return r
+}
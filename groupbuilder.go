@@ -0,0 +1,120 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package routing
+
+// GroupBuilder declares a tree of routes as data, independent of any
+// particular RouteGroup, so it can be built once and attached with Children.
+// It mirrors the route-registration methods of RouteGroup, but defers
+// registration until the builder is attached to a parent.
+type GroupBuilder struct {
+	prefix     string
+	middleware []Handler
+	routes     []func(*RouteGroup)
+	children   []*GroupBuilder
+}
+
+// NewGroupBuilder creates a GroupBuilder for the given path prefix, relative
+// to whichever RouteGroup it is eventually attached to via Children.
+func NewGroupBuilder(prefix string) *GroupBuilder {
+	return &GroupBuilder{prefix: prefix}
+}
+
+// Middleware appends handlers that apply only to this builder's own subtree.
+func (b *GroupBuilder) Middleware(handlers ...Handler) *GroupBuilder {
+	b.middleware = append(b.middleware, handlers...)
+	return b
+}
+
+// Children attaches nested GroupBuilders. Each inherits this builder's
+// prefix and handler chain once the whole tree is attached to a RouteGroup.
+func (b *GroupBuilder) Children(children ...*GroupBuilder) *GroupBuilder {
+	b.children = append(b.children, children...)
+	return b
+}
+
+func (b *GroupBuilder) route(register func(*RouteGroup)) *GroupBuilder {
+	b.routes = append(b.routes, register)
+	return b
+}
+
+// Get declares a GET route under this builder.
+func (b *GroupBuilder) Get(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Get(path, handlers...) })
+}
+
+// Post declares a POST route under this builder.
+func (b *GroupBuilder) Post(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Post(path, handlers...) })
+}
+
+// Put declares a PUT route under this builder.
+func (b *GroupBuilder) Put(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Put(path, handlers...) })
+}
+
+// Patch declares a PATCH route under this builder.
+func (b *GroupBuilder) Patch(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Patch(path, handlers...) })
+}
+
+// Delete declares a DELETE route under this builder.
+func (b *GroupBuilder) Delete(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Delete(path, handlers...) })
+}
+
+// Connect declares a CONNECT route under this builder.
+func (b *GroupBuilder) Connect(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Connect(path, handlers...) })
+}
+
+// Head declares a HEAD route under this builder.
+func (b *GroupBuilder) Head(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Head(path, handlers...) })
+}
+
+// Options declares an OPTIONS route under this builder.
+func (b *GroupBuilder) Options(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Options(path, handlers...) })
+}
+
+// Trace declares a TRACE route under this builder.
+func (b *GroupBuilder) Trace(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Trace(path, handlers...) })
+}
+
+// Any declares a route responding to every method in Methods under this builder.
+func (b *GroupBuilder) Any(path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Any(path, handlers...) })
+}
+
+// Handle declares a route for an arbitrary HTTP method under this builder.
+func (b *GroupBuilder) Handle(method, path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Handle(method, path, handlers...) })
+}
+
+// Match declares a route responding to the given HTTP methods under this builder.
+func (b *GroupBuilder) Match(methods []string, path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.Match(methods, path, handlers...) })
+}
+
+// To declares a route for a comma-joined list of HTTP methods under this builder.
+func (b *GroupBuilder) To(methods, path string, handlers ...Handler) *GroupBuilder {
+	return b.route(func(g *RouteGroup) { g.To(methods, path, handlers...) })
+}
+
+// build materializes this builder and its children under parent, which
+// already carries the accumulated prefix and handler chain of everything
+// above it in the tree.
+func (b *GroupBuilder) build(parent *RouteGroup) *RouteGroup {
+	group := parent.Group(b.prefix)
+	group.Use(b.middleware...)
+	for _, register := range b.routes {
+		register(group)
+	}
+	for _, child := range b.children {
+		child.build(group)
+	}
+	return group
+}
@@ -0,0 +1,46 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package routing
+
+import "github.com/valyala/fasthttp"
+
+// IRoutes defines the route-registration methods common to Router and
+// RouteGroup, so packages can register routes against "anything you can
+// attach routes to" without importing the concrete type.
+type IRoutes interface {
+	Get(path string, handlers ...Handler) *Route
+	Post(path string, handlers ...Handler) *Route
+	Put(path string, handlers ...Handler) *Route
+	Patch(path string, handlers ...Handler) *Route
+	Delete(path string, handlers ...Handler) *Route
+	Connect(path string, handlers ...Handler) *Route
+	Head(path string, handlers ...Handler) *Route
+	Options(path string, handlers ...Handler) *Route
+	Trace(path string, handlers ...Handler) *Route
+	Any(path string, handlers ...Handler) *Route
+	Handle(method, path string, handlers ...Handler) *Route
+	Match(methods []string, path string, handlers ...Handler) *Route
+	To(methods, path string, handlers ...Handler) *Route
+	Use(handlers ...Handler)
+	StaticFile(path, filepath string)
+	Static(prefix, root string)
+	StaticFS(prefix string, fs fasthttp.FS)
+}
+
+// IRouter additionally exposes grouping and introspection, so reusable
+// route-registration helpers can accept *Router or *RouteGroup alike.
+type IRouter interface {
+	IRoutes
+	Group(prefix string, handlers ...Handler) *RouteGroup
+	Children(children ...*GroupBuilder) *RouteGroup
+	BasePath() string
+}
+
+// Both concrete types this package offers routes on must satisfy IRouter;
+// this fails to compile the moment either drifts from the interface.
+var (
+	_ IRouter = (*Router)(nil)
+	_ IRouter = (*RouteGroup)(nil)
+)
@@ -0,0 +1,58 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package routing
+
+import (
+	"github.com/valyala/fasthttp"
+)
+
+// StaticFile registers a route that always serves the given filepath,
+// regardless of the requested path. It is useful for single files such as
+// favicon.ico or robots.txt that don't belong under a Static/StaticFS tree.
+func (r *RouteGroup) StaticFile(path, filepath string) {
+	handler := func(c *Context) error {
+		fasthttp.ServeFile(c.RequestCtx, filepath)
+		return nil
+	}
+	r.Get(path, handler)
+	r.Head(path, handler)
+}
+
+// Static serves files from the given root directory under prefix. A request
+// for prefix+"/css/app.css" serves the file root+"/css/app.css". Directory
+// listing is disabled; use StaticFS directly for more control.
+func (r *RouteGroup) Static(prefix, root string) {
+	r.StaticFS(prefix, fasthttp.FS{
+		Root:               root,
+		IndexNames:         []string{"index.html"},
+		GenerateIndexPages: false,
+		Compress:           true,
+		AcceptByteRange:    true,
+	})
+}
+
+// StaticFS serves files under prefix using the given fasthttp.FS, which
+// controls directory listing, byte-range support, gzip pre-compression and
+// any other fasthttp.FS behavior except fs.PathRewrite, which is always
+// replaced by a stripper that removes the group prefix plus prefix from the
+// request path. Path traversal is rejected by fasthttp.FS itself, which
+// jails all lookups under fs.Root.
+func (r *RouteGroup) StaticFS(prefix string, fs fasthttp.FS) {
+	fs.PathRewrite = fasthttp.NewPathPrefixStripper(len(r.prefix + prefix))
+	fsHandler := fs.NewRequestHandler()
+
+	route := prefix
+	if len(route) == 0 || route[len(route)-1] != '/' {
+		route += "/"
+	}
+	route += "*"
+
+	handler := func(c *Context) error {
+		fsHandler(c.RequestCtx)
+		return nil
+	}
+	r.Get(route, handler)
+	r.Head(route, handler)
+}